@@ -1,16 +1,27 @@
 package main
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
+	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/joho/godotenv"
+	"github.com/pratilipi/follow-service/internal/cache"
 	"github.com/pratilipi/follow-service/internal/database"
+	"github.com/pratilipi/follow-service/internal/events"
 	"github.com/pratilipi/follow-service/internal/handler"
+	"github.com/pratilipi/follow-service/internal/health"
+	"github.com/pratilipi/follow-service/internal/observability"
+	"github.com/pratilipi/follow-service/internal/outbox"
 	"github.com/pratilipi/follow-service/internal/repository"
 	pb "github.com/pratilipi/follow-service/proto/follow"
 	"go.uber.org/zap"
@@ -19,6 +30,9 @@ import (
 )
 
 func main() {
+	noCache := flag.Bool("no-cache", false, "disable the Redis-backed cache in front of the repository")
+	flag.Parse()
+
 	if err := godotenv.Load(); err != nil {
 		log.Println("No .env file found")
 	}
@@ -45,8 +59,37 @@ func main() {
 	}
 	logger.Info("database migrations completed successfully")
 
+	bgCtx, stopBackgroundTasks := context.WithCancel(context.Background())
+	defer stopBackgroundTasks()
+	observability.StartDBStatsCollector(bgCtx, db, 15*time.Second)
+
 	repo := repository.New(db)
-	followService := handler.NewFollowServiceServer(repo, logger)
+
+	var svcRepo handler.Repository = repo
+	if *noCache {
+		logger.Info("cache disabled via --no-cache")
+	} else {
+		redisAddr := getEnv("REDIS_ADDR", "localhost:6379")
+		redisCache := cache.NewRedisCache(redisAddr)
+		defer redisCache.Close()
+		svcRepo = cache.NewCachedRepository(repo, redisCache)
+		logger.Info("cache enabled", zap.String("redis_addr", redisAddr))
+	}
+
+	maxSubscribersPerUser := getEnvInt("MAX_SUBSCRIBERS_PER_USER", 10)
+	broker := events.NewBroker(maxSubscribersPerUser)
+	followService := handler.NewFollowServiceServer(svcRepo, broker, logger)
+
+	publisher, err := events.NewFromEnv()
+	if err != nil {
+		logger.Fatal("failed to initialize event publisher", zap.Error(err))
+	}
+	defer publisher.Close()
+
+	outboxWorker := outbox.NewWorker(repo, publisher, broker, logger, 2*time.Second)
+	go outboxWorker.Run(bgCtx)
+
+	go runCompactionLoop(bgCtx, repo, logger, compactionInterval, compactionRetention)
 
 	port := getEnv("GRPC_PORT", "50051")
 	
@@ -55,7 +98,16 @@ func main() {
 		logger.Fatal("failed to listen", zap.Error(err))
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			observability.TracingUnaryInterceptor(),
+			observability.MetricsUnaryInterceptor(),
+			observability.LoggingUnaryInterceptor(logger),
+		),
+		grpc.ChainStreamInterceptor(
+			observability.MetricsStreamInterceptor(),
+		),
+	)
 	pb.RegisterFollowServiceServer(grpcServer, followService)
 	reflection.Register(grpcServer)
 
@@ -67,12 +119,86 @@ func main() {
 		}
 	}()
 
+	healthChecker := health.NewHealthChecker(db, logger)
+
+	gwMux := runtime.NewServeMux()
+	if err := pb.RegisterFollowServiceHandlerServer(bgCtx, gwMux, followService); err != nil {
+		logger.Fatal("failed to register REST gateway", zap.Error(err))
+	}
+
+	httpMux := http.NewServeMux()
+	healthChecker.RegisterRoutes(httpMux)
+	httpMux.Handle("/v1/", withCORS(gwMux))
+	httpMux.Handle("/metrics", observability.Handler())
+
+	httpPort := getEnv("HTTP_PORT", "8080")
+	httpServer := &http.Server{
+		Addr:    ":" + httpPort,
+		Handler: httpMux,
+	}
+
+	go func() {
+		logger.Info("starting HTTP server", zap.String("port", httpPort))
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Fatal("failed to serve HTTP", zap.Error(err))
+		}
+	}()
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("shutting down server")
 	grpcServer.GracefulStop()
+	_ = httpServer.Shutdown(context.Background())
+}
+
+// compactionInterval and compactionRetention bound how aggressively cancelled
+// follow_activities rows are pruned: often enough that the table doesn't
+// grow unbounded, but with enough retention that GetFollowHistory still
+// covers a reasonable audit window.
+const (
+	compactionInterval  = 24 * time.Hour
+	compactionRetention = 90 * 24 * time.Hour
+)
+
+// runCompactionLoop periodically purges cancelled follow_activities rows
+// older than retention, until ctx is cancelled. It's meant to run for the
+// lifetime of the process, the same way outbox.Worker.Run does.
+func runCompactionLoop(ctx context.Context, repo *repository.Repository, logger *zap.Logger, interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := repo.CompactCancelledActivities(ctx, time.Now().Add(-retention))
+			if err != nil {
+				logger.Error("failed to compact cancelled follow activities", zap.Error(err))
+				continue
+			}
+			if removed > 0 {
+				logger.Info("compacted cancelled follow activities", zap.Int64("rows_removed", removed))
+			}
+		}
+	}
+}
+
+// withCORS allows the REST gateway to be called directly from browser
+// clients, since it otherwise has no CORS headers of its own.
+func withCORS(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
 }
 
 func getEnv(key, fallback string) string {
@@ -81,3 +207,15 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
@@ -0,0 +1,31 @@
+// Package cache provides a hot-path cache for follower/following counts and
+// relationship checks, and a decorator (CachedRepository) that layers it in
+// front of *repository.Repository.
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratilipi/follow-service/internal/models"
+)
+
+// Cache is the hot-path cache the read RPCs consult before hitting Postgres,
+// and that Follow/Unfollow update after their Postgres write succeeds.
+type Cache interface {
+	GetUser(ctx context.Context, userID int32) (user *models.User, found bool, err error)
+	SetUser(ctx context.Context, user *models.User, ttl time.Duration) error
+	IsUserNotFound(ctx context.Context, userID int32) (bool, error)
+	SetUserNotFound(ctx context.Context, userID int32, ttl time.Duration) error
+	InvalidateUser(ctx context.Context, userID int32) error
+
+	GetCounts(ctx context.Context, userID int32) (followers, following int32, found bool, err error)
+	IncrFollowingCount(ctx context.Context, userID int32, delta int32) error
+	IncrFollowersCount(ctx context.Context, userID int32, delta int32) error
+
+	IsFollowing(ctx context.Context, followerID, followingID int32) (isFollowing bool, found bool, err error)
+	AddFollowing(ctx context.Context, followerID, followingID int32) error
+	RemoveFollowing(ctx context.Context, followerID, followingID int32) error
+
+	Close() error
+}
@@ -0,0 +1,218 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pratilipi/follow-service/internal/models"
+	"github.com/redis/go-redis/v9"
+)
+
+// countsTTL is longer than the user-blob TTL: counts are kept fresh by
+// IncrFollowingCount/IncrFollowersCount between blob refreshes, so they don't
+// need to expire as eagerly.
+const countsTTL = 10 * time.Minute
+
+// followsSetCap bounds how many entries a follows:{id} set holds; adding
+// past the cap evicts the member least recently added or looked up.
+const followsSetCap = 500
+
+// incrIfExistsScript adjusts a counts key by ARGV[1] and refreshes its TTL,
+// but only if the key already exists. Without this guard, IncrBy on a key
+// that a Redis eviction or restart dropped would silently recreate it at
+// just the delta instead of the true accumulated count, and GetUser would
+// then trust that wrong value over the correct one from Postgres for up to
+// countsTTL. Leaving the key absent instead keeps GetCounts reporting a
+// cache miss until SetUser next backfills it from the repository.
+var incrIfExistsScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	redis.call("INCRBY", KEYS[1], ARGV[1])
+	redis.call("EXPIRE", KEYS[1], ARGV[2])
+end
+return redis.status_reply("OK")
+`)
+
+// RedisCache is the Redis-backed Cache implementation.
+type RedisCache struct {
+	client *redis.Client
+}
+
+func NewRedisCache(addr string) *RedisCache {
+	return &RedisCache{client: redis.NewClient(&redis.Options{Addr: addr})}
+}
+
+func (c *RedisCache) GetUser(ctx context.Context, userID int32) (*models.User, bool, error) {
+	data, err := c.client.Get(ctx, userKey(userID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get cached user: %w", err)
+	}
+
+	var user models.User
+	if err := json.Unmarshal(data, &user); err != nil {
+		return nil, false, fmt.Errorf("failed to unmarshal cached user: %w", err)
+	}
+
+	return &user, true, nil
+}
+
+func (c *RedisCache) SetUser(ctx context.Context, user *models.User, ttl time.Duration) error {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return fmt.Errorf("failed to marshal user: %w", err)
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.Set(ctx, userKey(user.ID), data, ttl)
+	pipe.Del(ctx, userNotFoundKey(user.ID))
+	pipe.Set(ctx, followersCountKey(user.ID), user.FollowersCount, countsTTL)
+	pipe.Set(ctx, followingCountKey(user.ID), user.FollowingCount, countsTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to cache user: %w", err)
+	}
+
+	return nil
+}
+
+// IsUserNotFound reports whether userID was recently looked up and found not
+// to exist, blunting repeated scraping lookups against GetUser.
+func (c *RedisCache) IsUserNotFound(ctx context.Context, userID int32) (bool, error) {
+	exists, err := c.client.Exists(ctx, userNotFoundKey(userID)).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to check negative cache: %w", err)
+	}
+	return exists > 0, nil
+}
+
+func (c *RedisCache) SetUserNotFound(ctx context.Context, userID int32, ttl time.Duration) error {
+	if err := c.client.Set(ctx, userNotFoundKey(userID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set negative cache: %w", err)
+	}
+	return nil
+}
+
+func (c *RedisCache) InvalidateUser(ctx context.Context, userID int32) error {
+	if err := c.client.Del(ctx, userKey(userID)).Err(); err != nil {
+		return fmt.Errorf("failed to invalidate cached user: %w", err)
+	}
+	return nil
+}
+
+func (c *RedisCache) GetCounts(ctx context.Context, userID int32) (int32, int32, bool, error) {
+	values, err := c.client.MGet(ctx, followersCountKey(userID), followingCountKey(userID)).Result()
+	if err != nil {
+		return 0, 0, false, fmt.Errorf("failed to get cached counts: %w", err)
+	}
+	if values[0] == nil || values[1] == nil {
+		return 0, 0, false, nil
+	}
+
+	followers, err := parseCount(values[0])
+	if err != nil {
+		return 0, 0, false, err
+	}
+	following, err := parseCount(values[1])
+	if err != nil {
+		return 0, 0, false, err
+	}
+
+	return followers, following, true, nil
+}
+
+func (c *RedisCache) IncrFollowingCount(ctx context.Context, userID int32, delta int32) error {
+	key := followingCountKey(userID)
+	if err := incrIfExistsScript.Run(ctx, c.client, []string{key}, delta, int(countsTTL.Seconds())).Err(); err != nil {
+		return fmt.Errorf("failed to adjust cached following count: %w", err)
+	}
+	return nil
+}
+
+func (c *RedisCache) IncrFollowersCount(ctx context.Context, userID int32, delta int32) error {
+	key := followersCountKey(userID)
+	if err := incrIfExistsScript.Run(ctx, c.client, []string{key}, delta, int(countsTTL.Seconds())).Err(); err != nil {
+		return fmt.Errorf("failed to adjust cached followers count: %w", err)
+	}
+	return nil
+}
+
+// IsFollowing consults the follower's follows:{id} set. The set holds only
+// entries added by AddFollowing, minus those evicted past followsSetCap, so
+// found is false on a cache miss (the set hasn't been populated, or doesn't
+// hold followingID),
+// meaning the caller should fall back to Postgres and backfill via
+// AddFollowing.
+func (c *RedisCache) IsFollowing(ctx context.Context, followerID, followingID int32) (bool, bool, error) {
+	key := followsKey(followerID)
+
+	exists, err := c.client.Exists(ctx, key).Result()
+	if err != nil {
+		return false, false, fmt.Errorf("failed to check follows set: %w", err)
+	}
+	if exists == 0 {
+		return false, false, nil
+	}
+
+	score, err := c.client.ZScore(ctx, key, followMember(followingID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, fmt.Errorf("failed to check follows set: %w", err)
+	}
+	_ = score
+
+	// Touch the member so it doesn't look least-recently-used on the next
+	// eviction pass.
+	c.client.ZAdd(ctx, key, redis.Z{Score: float64(time.Now().UnixNano()), Member: followMember(followingID)})
+
+	return true, true, nil
+}
+
+func (c *RedisCache) AddFollowing(ctx context.Context, followerID, followingID int32) error {
+	key := followsKey(followerID)
+
+	pipe := c.client.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(time.Now().UnixNano()), Member: followMember(followingID)})
+	pipe.ZRemRangeByRank(ctx, key, 0, -followsSetCap-1)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to cache follows set entry: %w", err)
+	}
+
+	return nil
+}
+
+func (c *RedisCache) RemoveFollowing(ctx context.Context, followerID, followingID int32) error {
+	if err := c.client.ZRem(ctx, followsKey(followerID), followMember(followingID)).Err(); err != nil {
+		return fmt.Errorf("failed to remove follows set entry: %w", err)
+	}
+	return nil
+}
+
+func (c *RedisCache) Close() error {
+	return c.client.Close()
+}
+
+func parseCount(value interface{}) (int32, error) {
+	s, ok := value.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected count value type %T", value)
+	}
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse cached count: %w", err)
+	}
+	return int32(n), nil
+}
+
+func userKey(userID int32) string           { return fmt.Sprintf("user:%d", userID) }
+func userNotFoundKey(userID int32) string   { return fmt.Sprintf("user:%d:notfound", userID) }
+func followersCountKey(userID int32) string { return fmt.Sprintf("count:followers:%d", userID) }
+func followingCountKey(userID int32) string { return fmt.Sprintf("count:following:%d", userID) }
+func followsKey(followerID int32) string    { return fmt.Sprintf("follows:%d", followerID) }
+func followMember(followingID int32) string { return strconv.Itoa(int(followingID)) }
@@ -0,0 +1,129 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pratilipi/follow-service/internal/models"
+	"github.com/pratilipi/follow-service/internal/repository"
+)
+
+const (
+	userTTL         = 30 * time.Second
+	userNotFoundTTL = 10 * time.Second
+)
+
+// CachedRepository wraps *repository.Repository with a Cache, so hot reads
+// (user lookups, follower/following counts, relationship checks) avoid
+// Postgres on the common path. It implements the same surface as
+// *repository.Repository, so handler.FollowServiceServer can hold either one
+// behind the handler.Repository interface.
+type CachedRepository struct {
+	repo  *repository.Repository
+	cache Cache
+}
+
+func NewCachedRepository(repo *repository.Repository, cache Cache) *CachedRepository {
+	return &CachedRepository{repo: repo, cache: cache}
+}
+
+func (c *CachedRepository) GetUser(ctx context.Context, userID int32) (*models.User, error) {
+	if notFound, err := c.cache.IsUserNotFound(ctx, userID); err == nil && notFound {
+		return nil, repository.ErrUserNotFound
+	}
+
+	user, found, err := c.cache.GetUser(ctx, userID)
+	if err != nil || !found {
+		user, err = c.repo.GetUser(ctx, userID)
+		if errors.Is(err, repository.ErrUserNotFound) {
+			_ = c.cache.SetUserNotFound(ctx, userID, userNotFoundTTL)
+			return nil, err
+		}
+		if err != nil {
+			return nil, err
+		}
+		_ = c.cache.SetUser(ctx, user, userTTL)
+	}
+
+	if followers, following, found, err := c.cache.GetCounts(ctx, userID); err == nil && found {
+		user.FollowersCount = followers
+		user.FollowingCount = following
+	}
+
+	return user, nil
+}
+
+func (c *CachedRepository) ListUsers(ctx context.Context, limit, offset int32) ([]*models.User, int32, error) {
+	return c.repo.ListUsers(ctx, limit, offset)
+}
+
+func (c *CachedRepository) Follow(ctx context.Context, followerID, followingID int32) error {
+	if err := c.repo.Follow(ctx, followerID, followingID); err != nil {
+		return err
+	}
+
+	_ = c.cache.IncrFollowingCount(ctx, followerID, 1)
+	_ = c.cache.IncrFollowersCount(ctx, followingID, 1)
+	_ = c.cache.AddFollowing(ctx, followerID, followingID)
+	_ = c.cache.InvalidateUser(ctx, followerID)
+	_ = c.cache.InvalidateUser(ctx, followingID)
+
+	return nil
+}
+
+func (c *CachedRepository) Unfollow(ctx context.Context, followerID, followingID int32) error {
+	if err := c.repo.Unfollow(ctx, followerID, followingID); err != nil {
+		return err
+	}
+
+	_ = c.cache.IncrFollowingCount(ctx, followerID, -1)
+	_ = c.cache.IncrFollowersCount(ctx, followingID, -1)
+	_ = c.cache.RemoveFollowing(ctx, followerID, followingID)
+	_ = c.cache.InvalidateUser(ctx, followerID)
+	_ = c.cache.InvalidateUser(ctx, followingID)
+
+	return nil
+}
+
+func (c *CachedRepository) GetFollowers(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error) {
+	return c.repo.GetFollowers(ctx, userID, limit, offset)
+}
+
+func (c *CachedRepository) GetFollowing(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error) {
+	return c.repo.GetFollowing(ctx, userID, limit, offset)
+}
+
+func (c *CachedRepository) GetFollowHistory(ctx context.Context, userID int32, since time.Time, limit int32) ([]*models.FollowActivity, error) {
+	return c.repo.GetFollowHistory(ctx, userID, since, limit)
+}
+
+func (c *CachedRepository) CheckRelationships(ctx context.Context, viewerID int32, targetIDs []int32) (map[int32]models.RelationshipFlags, error) {
+	return c.repo.CheckRelationships(ctx, viewerID, targetIDs)
+}
+
+func (c *CachedRepository) IsFollowing(ctx context.Context, followerID, followingID int32) (bool, error) {
+	if isFollowing, found, err := c.cache.IsFollowing(ctx, followerID, followingID); err == nil && found {
+		return isFollowing, nil
+	}
+
+	isFollowing, err := c.repo.IsFollowing(ctx, followerID, followingID)
+	if err != nil {
+		return false, err
+	}
+	if isFollowing {
+		_ = c.cache.AddFollowing(ctx, followerID, followingID)
+	}
+
+	return isFollowing, nil
+}
+
+// WarmCache loads userID straight from Postgres and backfills the cache,
+// for use ahead of an expected traffic spike (e.g. a creator about to post).
+func (c *CachedRepository) WarmCache(ctx context.Context, userID int32) error {
+	user, err := c.repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return c.cache.SetUser(ctx, user, userTTL)
+}
@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pratilipi/follow-service/internal/models"
+	"github.com/pratilipi/follow-service/internal/repository"
+)
+
+// fakeCache is a minimal in-memory Cache double, just enough to drive
+// CachedRepository's merge-on-read behavior in GetUser.
+type fakeCache struct {
+	user             *models.User
+	userFound        bool
+	followers        int32
+	following        int32
+	countsFound      bool
+	setUserCallCount int
+}
+
+func (f *fakeCache) GetUser(ctx context.Context, userID int32) (*models.User, bool, error) {
+	return f.user, f.userFound, nil
+}
+func (f *fakeCache) SetUser(ctx context.Context, user *models.User, ttl time.Duration) error {
+	f.setUserCallCount++
+	return nil
+}
+func (f *fakeCache) IsUserNotFound(ctx context.Context, userID int32) (bool, error) { return false, nil }
+func (f *fakeCache) SetUserNotFound(ctx context.Context, userID int32, ttl time.Duration) error {
+	return nil
+}
+func (f *fakeCache) InvalidateUser(ctx context.Context, userID int32) error { return nil }
+func (f *fakeCache) GetCounts(ctx context.Context, userID int32) (int32, int32, bool, error) {
+	return f.followers, f.following, f.countsFound, nil
+}
+func (f *fakeCache) IncrFollowingCount(ctx context.Context, userID int32, delta int32) error {
+	return nil
+}
+func (f *fakeCache) IncrFollowersCount(ctx context.Context, userID int32, delta int32) error {
+	return nil
+}
+func (f *fakeCache) IsFollowing(ctx context.Context, followerID, followingID int32) (bool, bool, error) {
+	return false, false, nil
+}
+func (f *fakeCache) AddFollowing(ctx context.Context, followerID, followingID int32) error {
+	return nil
+}
+func (f *fakeCache) RemoveFollowing(ctx context.Context, followerID, followingID int32) error {
+	return nil
+}
+func (f *fakeCache) Close() error { return nil }
+
+func newTestCachedRepository(t *testing.T) (*CachedRepository, sqlmock.Sqlmock, *fakeCache) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	cache := &fakeCache{}
+	return NewCachedRepository(repository.New(db), cache), mock, cache
+}
+
+// TestGetUserMergesCountsOnCacheHit covers the case the cache has a warm
+// counts entry: GetUser should prefer it over the blob it came from, since
+// IncrFollowingCount/IncrFollowersCount keep it fresher between blob
+// refreshes.
+func TestGetUserMergesCountsOnCacheHit(t *testing.T) {
+	repo, _, cache := newTestCachedRepository(t)
+
+	cache.user = &models.User{ID: 1, Username: "ada", FollowersCount: 100, FollowingCount: 50}
+	cache.userFound = true
+	cache.followers, cache.following, cache.countsFound = 142, 50, true
+
+	user, err := repo.GetUser(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.FollowersCount != 142 {
+		t.Errorf("expected cached counts to win, got FollowersCount=%d", user.FollowersCount)
+	}
+}
+
+// TestGetUserKeepsRepoCountsOnCountsCacheMiss covers the bug this test
+// guards against: if GetCounts reports a miss (e.g. after a Redis
+// eviction), GetUser must not trust a stale or partially-initialized
+// cached value — it should pass through whatever the blob/repo had.
+func TestGetUserKeepsRepoCountsOnCountsCacheMiss(t *testing.T) {
+	repo, _, cache := newTestCachedRepository(t)
+
+	cache.user = &models.User{ID: 1, Username: "ada", FollowersCount: 100, FollowingCount: 50}
+	cache.userFound = true
+	cache.countsFound = false
+
+	user, err := repo.GetUser(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.FollowersCount != 100 || user.FollowingCount != 50 {
+		t.Errorf("expected blob counts to survive a counts cache miss, got %+v", user)
+	}
+}
+
+func TestGetUserFallsBackToRepoOnUserCacheMiss(t *testing.T) {
+	repo, mock, cache := newTestCachedRepository(t)
+	cache.userFound = false
+	cache.countsFound = false
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT id, username, email, followers_count, following_count, created_at FROM users").
+		WithArgs(int32(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "email", "followers_count", "following_count", "created_at"}).
+			AddRow(int32(7), "grace", "grace@example.com", int32(3), int32(4), now))
+
+	user, err := repo.GetUser(context.Background(), 7)
+	if err != nil {
+		t.Fatalf("GetUser: %v", err)
+	}
+	if user.FollowersCount != 3 || user.FollowingCount != 4 {
+		t.Errorf("expected repo-sourced counts, got %+v", user)
+	}
+	if cache.setUserCallCount != 1 {
+		t.Errorf("expected GetUser to backfill the cache once, got %d calls", cache.setUserCallCount)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
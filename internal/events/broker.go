@@ -0,0 +1,110 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// FollowEventType identifies the kind of change a FollowEvent reports.
+type FollowEventType string
+
+const (
+	FollowEventTypeFollowed   FollowEventType = "FOLLOWED"
+	FollowEventTypeUnfollowed FollowEventType = "UNFOLLOWED"
+)
+
+// FollowEvent is pushed to subscribers of a user's follower activity.
+type FollowEvent struct {
+	Type      FollowEventType
+	ActorID   int32
+	Timestamp time.Time
+}
+
+// ErrTooManySubscribers is returned when a user already has the broker's
+// maxSubscribersPerUser live subscriptions.
+var ErrTooManySubscribers = errors.New("too many subscribers for this user")
+
+const subscriberBufferSize = 32
+
+// Broker is an in-process pub/sub that fans FollowEvents out to subscribers
+// of a given user. It's fed by the outbox worker as events are published, so
+// a subscriber sees the same events a downstream consumer would.
+type Broker struct {
+	mu                    sync.Mutex
+	subscribers           map[int32]map[chan FollowEvent]struct{}
+	maxSubscribersPerUser int
+}
+
+func NewBroker(maxSubscribersPerUser int) *Broker {
+	return &Broker{
+		subscribers:           make(map[int32]map[chan FollowEvent]struct{}),
+		maxSubscribersPerUser: maxSubscribersPerUser,
+	}
+}
+
+// Subscribe registers a buffered channel for userID's FollowEvents and
+// returns it along with an unsubscribe func. The channel is closed and
+// removed automatically when ctx is done.
+func (b *Broker) Subscribe(ctx context.Context, userID int32) (<-chan FollowEvent, func(), error) {
+	b.mu.Lock()
+	if b.maxSubscribersPerUser > 0 && len(b.subscribers[userID]) >= b.maxSubscribersPerUser {
+		b.mu.Unlock()
+		return nil, nil, ErrTooManySubscribers
+	}
+
+	ch := make(chan FollowEvent, subscriberBufferSize)
+	if b.subscribers[userID] == nil {
+		b.subscribers[userID] = make(map[chan FollowEvent]struct{})
+	}
+	b.subscribers[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	unsubscribe := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers[userID], ch)
+			if len(b.subscribers[userID]) == 0 {
+				delete(b.subscribers, userID)
+			}
+			close(ch)
+			b.mu.Unlock()
+		})
+	}
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+
+	return ch, unsubscribe, nil
+}
+
+// Publish delivers event to every live subscriber of userID. A subscriber
+// whose buffer is full has its oldest queued event dropped so one slow
+// client can't block delivery to the others.
+//
+// The send happens while holding b.mu, the same lock unsubscribe takes
+// before closing a channel, so Publish can never send on a channel that
+// has already been (or is concurrently being) closed.
+func (b *Broker) Publish(userID int32, event FollowEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subscribers[userID] {
+		select {
+		case ch <- event:
+		default:
+			select {
+			case <-ch:
+			default:
+			}
+			select {
+			case ch <- event:
+			default:
+			}
+		}
+	}
+}
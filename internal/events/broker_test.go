@@ -0,0 +1,79 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestBrokerPublishUnsubscribeRace exercises the ordinary case of a
+// StreamFollowerEvents client disconnecting (cancelling its context) while
+// events for the same user are being published concurrently. Before the fix
+// this could panic with "send on closed channel" if Publish's snapshot of a
+// subscriber channel outlived that channel's close.
+func TestBrokerPublishUnsubscribeRace(t *testing.T) {
+	b := NewBroker(0)
+
+	const userID = int32(1)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch, _, err := b.Subscribe(ctx, userID)
+		if err != nil {
+			t.Fatalf("Subscribe: %v", err)
+		}
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range ch {
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			cancel()
+		}()
+
+		for j := 0; j < 10; j++ {
+			b.Publish(userID, FollowEvent{Type: FollowEventTypeFollowed, Timestamp: time.Now()})
+		}
+	}
+
+	wg.Wait()
+}
+
+func TestBrokerSubscribeMaxSubscribers(t *testing.T) {
+	b := NewBroker(1)
+	ctx := context.Background()
+
+	if _, _, err := b.Subscribe(ctx, 1); err != nil {
+		t.Fatalf("first Subscribe: %v", err)
+	}
+	if _, _, err := b.Subscribe(ctx, 1); err != ErrTooManySubscribers {
+		t.Fatalf("expected ErrTooManySubscribers, got %v", err)
+	}
+}
+
+func TestBrokerUnsubscribeIsIdempotentAndRemovesUser(t *testing.T) {
+	b := NewBroker(0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, unsubscribe, err := b.Subscribe(ctx, 7)
+	if err != nil {
+		t.Fatalf("Subscribe: %v", err)
+	}
+
+	unsubscribe()
+	unsubscribe() // must not panic or double-close
+
+	if _, ok := <-ch; ok {
+		t.Fatalf("expected channel to be closed after unsubscribe")
+	}
+
+	if len(b.subscribers) != 0 {
+		t.Fatalf("expected subscribers map to be empty, got %v", b.subscribers)
+	}
+}
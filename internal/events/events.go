@@ -0,0 +1,14 @@
+// Package events publishes follow/unfollow notifications to a downstream
+// message sink. Publishers are consumed by internal/outbox, which drives
+// them from the follow_outbox table rather than the request path, so a
+// publish failure never rolls back a Follow/Unfollow.
+package events
+
+import "context"
+
+// EventPublisher delivers follow/unfollow events to a message sink.
+type EventPublisher interface {
+	PublishFollow(ctx context.Context, followerID, followingID int32) error
+	PublishUnfollow(ctx context.Context, followerID, followingID int32) error
+	Close() error
+}
@@ -0,0 +1,33 @@
+package events
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NewFromEnv builds the EventPublisher selected by the EVENT_SINK env var
+// ("kafka" or "nats", defaulting to "kafka").
+func NewFromEnv() (EventPublisher, error) {
+	switch sink := strings.ToLower(getEnv("EVENT_SINK", "kafka")); sink {
+	case "kafka":
+		brokers := strings.Split(getEnv("KAFKA_BROKERS", "localhost:9092"), ",")
+		topic := getEnv("KAFKA_FOLLOW_TOPIC", "follow-events")
+		return NewKafkaPublisher(brokers, topic), nil
+	case "nats":
+		url := getEnv("NATS_URL", nats.DefaultURL)
+		subject := getEnv("NATS_FOLLOW_SUBJECT", "follow.events")
+		return NewNATSPublisher(url, subject)
+	default:
+		return nil, fmt.Errorf("unknown EVENT_SINK %q", sink)
+	}
+}
+
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
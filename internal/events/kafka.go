@@ -0,0 +1,63 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	kafka "github.com/segmentio/kafka-go"
+)
+
+// KafkaPublisher publishes follow/unfollow events to a Kafka topic.
+type KafkaPublisher struct {
+	writer *kafka.Writer
+}
+
+func NewKafkaPublisher(brokers []string, topic string) *KafkaPublisher {
+	return &KafkaPublisher{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.LeastBytes{},
+			RequiredAcks: kafka.RequireOne,
+		},
+	}
+}
+
+func (p *KafkaPublisher) PublishFollow(ctx context.Context, followerID, followingID int32) error {
+	return p.publish(ctx, "FOLLOWED", followerID, followingID)
+}
+
+func (p *KafkaPublisher) PublishUnfollow(ctx context.Context, followerID, followingID int32) error {
+	return p.publish(ctx, "UNFOLLOWED", followerID, followingID)
+}
+
+func (p *KafkaPublisher) publish(ctx context.Context, eventType string, followerID, followingID int32) error {
+	payload, err := json.Marshal(followEventPayload{
+		EventType:   eventType,
+		FollowerID:  followerID,
+		FollowingID: followingID,
+		Timestamp:   time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal follow event: %w", err)
+	}
+
+	if err := p.writer.WriteMessages(ctx, kafka.Message{Value: payload}); err != nil {
+		return fmt.Errorf("failed to publish follow event to kafka: %w", err)
+	}
+
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.writer.Close()
+}
+
+type followEventPayload struct {
+	EventType   string    `json:"event_type"`
+	FollowerID  int32     `json:"follower_id"`
+	FollowingID int32     `json:"following_id"`
+	Timestamp   time.Time `json:"timestamp"`
+}
@@ -0,0 +1,56 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSPublisher publishes follow/unfollow events to a NATS subject.
+type NATSPublisher struct {
+	conn    *nats.Conn
+	subject string
+}
+
+func NewNATSPublisher(url, subject string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	return &NATSPublisher{conn: conn, subject: subject}, nil
+}
+
+func (p *NATSPublisher) PublishFollow(ctx context.Context, followerID, followingID int32) error {
+	return p.publish(ctx, "FOLLOWED", followerID, followingID)
+}
+
+func (p *NATSPublisher) PublishUnfollow(ctx context.Context, followerID, followingID int32) error {
+	return p.publish(ctx, "UNFOLLOWED", followerID, followingID)
+}
+
+func (p *NATSPublisher) publish(_ context.Context, eventType string, followerID, followingID int32) error {
+	payload, err := json.Marshal(followEventPayload{
+		EventType:   eventType,
+		FollowerID:  followerID,
+		FollowingID: followingID,
+		Timestamp:   time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal follow event: %w", err)
+	}
+
+	if err := p.conn.Publish(p.subject, payload); err != nil {
+		return fmt.Errorf("failed to publish follow event to nats: %w", err)
+	}
+
+	return nil
+}
+
+func (p *NATSPublisher) Close() error {
+	p.conn.Close()
+	return nil
+}
@@ -3,24 +3,46 @@ package handler
 import (
 	"context"
 	"errors"
+	"time"
 
-	pb "github.com/pratilipi/follow-service/proto/follow"
+	"github.com/pratilipi/follow-service/internal/events"
 	"github.com/pratilipi/follow-service/internal/models"
 	"github.com/pratilipi/follow-service/internal/repository"
+	pb "github.com/pratilipi/follow-service/proto/follow"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Repository is the subset of *repository.Repository the handler depends
+// on. It's satisfied directly by *repository.Repository and by
+// *cache.CachedRepository, so the server doesn't care whether caching is
+// enabled.
+type Repository interface {
+	GetUser(ctx context.Context, userID int32) (*models.User, error)
+	ListUsers(ctx context.Context, limit, offset int32) ([]*models.User, int32, error)
+	Follow(ctx context.Context, followerID, followingID int32) error
+	Unfollow(ctx context.Context, followerID, followingID int32) error
+	GetFollowers(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error)
+	GetFollowing(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error)
+	GetFollowHistory(ctx context.Context, userID int32, since time.Time, limit int32) ([]*models.FollowActivity, error)
+	CheckRelationships(ctx context.Context, viewerID int32, targetIDs []int32) (map[int32]models.RelationshipFlags, error)
+	IsFollowing(ctx context.Context, followerID, followingID int32) (bool, error)
+	WarmCache(ctx context.Context, userID int32) error
+}
+
 type FollowServiceServer struct {
 	pb.UnimplementedFollowServiceServer
-	repo   *repository.Repository
+	repo   Repository
+	broker *events.Broker
 	logger *zap.Logger
 }
 
-func NewFollowServiceServer(repo *repository.Repository, logger *zap.Logger) *FollowServiceServer {
+func NewFollowServiceServer(repo Repository, broker *events.Broker, logger *zap.Logger) *FollowServiceServer {
 	return &FollowServiceServer{
 		repo:   repo,
+		broker: broker,
 		logger: logger,
 	}
 }
@@ -35,11 +57,6 @@ func (s *FollowServiceServer) Follow(ctx context.Context, req *pb.FollowRequest)
 		return nil, mapError(err)
 	}
 
-	s.logger.Info("user followed successfully",
-		zap.Int32("follower_id", req.FollowerId),
-		zap.Int32("following_id", req.FollowingId),
-	)
-
 	return &pb.FollowResponse{
 		Success: true,
 		Message: "successfully followed user",
@@ -56,11 +73,6 @@ func (s *FollowServiceServer) Unfollow(ctx context.Context, req *pb.UnfollowRequ
 		return nil, mapError(err)
 	}
 
-	s.logger.Info("user unfollowed successfully",
-		zap.Int32("follower_id", req.FollowerId),
-		zap.Int32("following_id", req.FollowingId),
-	)
-
 	return &pb.UnfollowResponse{
 		Success: true,
 		Message: "successfully unfollowed user",
@@ -171,6 +183,140 @@ func (s *FollowServiceServer) ListUsers(ctx context.Context, req *pb.ListUsersRe
 	}, nil
 }
 
+func (s *FollowServiceServer) GetFollowHistory(ctx context.Context, req *pb.GetFollowHistoryRequest) (*pb.GetFollowHistoryResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	var since time.Time
+	if req.Since != nil {
+		since = req.Since.AsTime()
+	}
+
+	activities, err := s.repo.GetFollowHistory(ctx, req.UserId, since, limit)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	pbActivities := make([]*pb.FollowActivity, len(activities))
+	for i, activity := range activities {
+		pbActivities[i] = &pb.FollowActivity{
+			Id:          activity.ID,
+			FollowerId:  activity.FollowerID,
+			FollowingId: activity.FollowingID,
+			Cancelled:   activity.Cancelled,
+			CreatedAt:   timestamppb.New(activity.CreatedAt),
+			UpdatedAt:   timestamppb.New(activity.UpdatedAt),
+		}
+	}
+
+	return &pb.GetFollowHistoryResponse{
+		Activities: pbActivities,
+	}, nil
+}
+
+func (s *FollowServiceServer) CheckRelationships(ctx context.Context, req *pb.CheckRelationshipsRequest) (*pb.CheckRelationshipsResponse, error) {
+	if req.ViewerId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid viewer ID")
+	}
+	if len(req.TargetIds) > repository.MaxRelationshipTargets {
+		return nil, status.Error(codes.InvalidArgument, "too many target ids")
+	}
+
+	flags, err := s.repo.CheckRelationships(ctx, req.ViewerId, req.TargetIds)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	relationships := make(map[int32]*pb.RelationshipFlags, len(flags))
+	for targetID, f := range flags {
+		relationships[targetID] = &pb.RelationshipFlags{
+			IsFollowing:  f.IsFollowing,
+			IsFollowedBy: f.IsFollowedBy,
+			IsMutual:     f.IsMutual(),
+		}
+	}
+
+	return &pb.CheckRelationshipsResponse{
+		Relationships: relationships,
+	}, nil
+}
+
+func (s *FollowServiceServer) IsFollowing(ctx context.Context, req *pb.IsFollowingRequest) (*pb.IsFollowingResponse, error) {
+	if req.FollowerId <= 0 || req.FollowingId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user IDs")
+	}
+
+	isFollowing, err := s.repo.IsFollowing(ctx, req.FollowerId, req.FollowingId)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return &pb.IsFollowingResponse{
+		IsFollowing: isFollowing,
+	}, nil
+}
+
+// WarmCache pre-loads userID's hot data (user row, follower/following
+// counts) into cache. It's a no-op when caching is disabled.
+func (s *FollowServiceServer) WarmCache(ctx context.Context, req *pb.WarmCacheRequest) (*pb.WarmCacheResponse, error) {
+	if req.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	if err := s.repo.WarmCache(ctx, req.UserId); err != nil {
+		return nil, mapError(err)
+	}
+
+	return &pb.WarmCacheResponse{Success: true}, nil
+}
+
+func (s *FollowServiceServer) StreamFollowerEvents(req *pb.StreamFollowerEventsRequest, stream pb.FollowService_StreamFollowerEventsServer) error {
+	if req.UserId <= 0 {
+		return status.Error(codes.InvalidArgument, "invalid user ID")
+	}
+
+	ch, unsubscribe, err := s.broker.Subscribe(stream.Context(), req.UserId)
+	if err != nil {
+		return mapError(err)
+	}
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(&pb.FollowEvent{
+				Type:      followEventTypeToProto(event.Type),
+				ActorId:   event.ActorID,
+				Timestamp: timestamppb.New(event.Timestamp),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func followEventTypeToProto(t events.FollowEventType) pb.FollowEventType {
+	switch t {
+	case events.FollowEventTypeFollowed:
+		return pb.FollowEventType_FOLLOWED
+	case events.FollowEventTypeUnfollowed:
+		return pb.FollowEventType_UNFOLLOWED
+	default:
+		return pb.FollowEventType_FOLLOW_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
 func mapError(err error) error {
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
@@ -181,6 +327,10 @@ func mapError(err error) error {
 		return status.Error(codes.NotFound, "not following this user")
 	case errors.Is(err, repository.ErrSelfFollow):
 		return status.Error(codes.InvalidArgument, "cannot follow yourself")
+	case errors.Is(err, repository.ErrTooManyTargets):
+		return status.Error(codes.InvalidArgument, "too many target ids")
+	case errors.Is(err, events.ErrTooManySubscribers):
+		return status.Error(codes.ResourceExhausted, "too many subscribers for this user")
 	default:
 		return status.Error(codes.Internal, "internal server error")
 	}
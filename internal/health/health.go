@@ -41,10 +41,17 @@ func (h *HealthChecker) ReadinessHandler(w http.ResponseWriter, r *http.Request)
 	w.Write([]byte("Ready"))
 }
 
-func (h *HealthChecker) StartHealthServer(port string) error {
-	mux := http.NewServeMux()
+// RegisterRoutes mounts the liveness/readiness handlers onto mux, so the
+// health checks can share a listener with other HTTP front doors (e.g. the
+// REST gateway) instead of each owning its own http.Server.
+func (h *HealthChecker) RegisterRoutes(mux *http.ServeMux) {
 	mux.HandleFunc("/health/live", h.LivenessHandler)
 	mux.HandleFunc("/health/ready", h.ReadinessHandler)
+}
+
+func (h *HealthChecker) StartHealthServer(port string) error {
+	mux := http.NewServeMux()
+	h.RegisterRoutes(mux)
 
 	h.logger.Info("starting health check server", zap.String("port", port))
 	return http.ListenAndServe(":"+port, mux)
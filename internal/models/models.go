@@ -16,3 +16,47 @@ type Follow struct {
 	FollowingID int32
 	CreatedAt   time.Time
 }
+
+// RelationshipFlags summarizes how a viewer relates to a target user so a
+// caller can render "Follow / Following / Follows you" in a single lookup.
+type RelationshipFlags struct {
+	IsFollowing  bool
+	IsFollowedBy bool
+}
+
+// IsMutual reports whether the viewer and target follow each other.
+func (f RelationshipFlags) IsMutual() bool {
+	return f.IsFollowing && f.IsFollowedBy
+}
+
+// OutboxEventType identifies the kind of relationship change recorded in
+// follow_outbox.
+type OutboxEventType string
+
+const (
+	OutboxEventFollowed   OutboxEventType = "FOLLOWED"
+	OutboxEventUnfollowed OutboxEventType = "UNFOLLOWED"
+)
+
+// OutboxEvent is a row of the follow_outbox table, written in the same
+// transaction as the Follow/Unfollow it records so downstream publishing
+// never drifts from the committed relationship state.
+type OutboxEvent struct {
+	ID          int64
+	EventType   OutboxEventType
+	FollowerID  int32
+	FollowingID int32
+	CreatedAt   time.Time
+}
+
+// FollowActivity is a single row of the follow_activities audit log. Unlike
+// Follow, it also represents cancelled (unfollowed) entries so callers can
+// reconstruct the full history of a relationship.
+type FollowActivity struct {
+	ID          int64
+	FollowerID  int32
+	FollowingID int32
+	Cancelled   bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
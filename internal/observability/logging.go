@@ -0,0 +1,62 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// requestSummaryMaxLen bounds how much of a request's string form lands in
+// the log line, so a large CheckRelationships target_ids list doesn't blow
+// up log volume.
+const requestSummaryMaxLen = 200
+
+// LoggingUnaryInterceptor emits one structured log line per RPC with the
+// method, duration, resulting status code, caller address, and a bounded
+// request summary. It replaces the ad-hoc s.logger.Info calls that used to
+// live inside individual handler methods.
+func LoggingUnaryInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		fields := append([]zap.Field{
+			zap.String("method", info.FullMethod),
+			zap.Duration("duration", time.Since(start)),
+			zap.String("code", status.Code(err).String()),
+			zap.String("peer", peerAddr(ctx)),
+			zap.String("request", requestSummary(req)),
+		}, TraceFields(ctx)...)
+
+		if err != nil {
+			logger.Warn("rpc failed", fields...)
+		} else {
+			logger.Info("rpc completed", fields...)
+		}
+
+		return resp, err
+	}
+}
+
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	return p.Addr.String()
+}
+
+// requestSummary renders req as a bounded summary string, truncating rather
+// than logging an arbitrarily large request body verbatim.
+func requestSummary(req interface{}) string {
+	s := fmt.Sprintf("%+v", req)
+	if len(s) > requestSummaryMaxLen {
+		return s[:requestSummaryMaxLen] + "...(truncated)"
+	}
+	return s
+}
@@ -0,0 +1,101 @@
+package observability
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "follow_rpc_requests_total",
+		Help: "Total number of FollowService RPCs, by method and status code.",
+	}, []string{"method", "code"})
+
+	rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "follow_rpc_duration_seconds",
+		Help:    "FollowService RPC latency in seconds, by method.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+
+	activeStreams = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "follow_rpc_active_streams",
+		Help: "Number of currently open StreamFollowerEvents streams.",
+	})
+
+	dbOpenConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "follow_db_open_connections",
+		Help: "Open connections in the database/sql pool (sql.DB.Stats().OpenConnections).",
+	})
+	dbInUseConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "follow_db_in_use_connections",
+		Help: "In-use connections in the database/sql pool.",
+	})
+	dbIdleConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "follow_db_idle_connections",
+		Help: "Idle connections in the database/sql pool.",
+	})
+	dbWaitCountTotal = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "follow_db_wait_count_total",
+		Help: "Cumulative number of connections waited for, from sql.DB.Stats().WaitCount.",
+	})
+)
+
+// Handler serves the Prometheus text exposition format for scraping.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// MetricsUnaryInterceptor records a request count and latency observation for
+// every unary RPC, labelled by method and the resulting gRPC status code.
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		rpcDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+		rpcRequestsTotal.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+
+		return resp, err
+	}
+}
+
+// MetricsStreamInterceptor tracks how many server-streaming RPCs (currently
+// just StreamFollowerEvents) are open at once.
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		activeStreams.Inc()
+		defer activeStreams.Dec()
+		return handler(srv, ss)
+	}
+}
+
+// StartDBStatsCollector polls db.Stats() every interval and republishes it as
+// gauges, until ctx is cancelled. It's meant to run for the lifetime of the
+// process, the same way outbox.Worker.Run does.
+func StartDBStatsCollector(ctx context.Context, db *sql.DB, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				stats := db.Stats()
+				dbOpenConnections.Set(float64(stats.OpenConnections))
+				dbInUseConnections.Set(float64(stats.InUse))
+				dbIdleConnections.Set(float64(stats.Idle))
+				dbWaitCountTotal.Set(float64(stats.WaitCount))
+			}
+		}
+	}()
+}
@@ -0,0 +1,53 @@
+// Package observability holds the gRPC interceptors and helpers that give
+// operators visibility into the service: Prometheus metrics, OpenTelemetry
+// tracing, and structured per-RPC request logging.
+package observability
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+var tracer = otel.Tracer("github.com/pratilipi/follow-service")
+
+// StartSpan starts a child span named name under the span (if any) already
+// active on ctx. Repository methods use it to wrap individual SQL calls so a
+// slow query shows up as its own span in the RPC's trace.
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name)
+}
+
+// TracingUnaryInterceptor starts a span per unary RPC, named after the gRPC
+// method, and records the handler's error (if any) on it.
+func TracingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := tracer.Start(ctx, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
+		}
+
+		return resp, err
+	}
+}
+
+// TraceFields returns zap fields carrying the trace and span ID of whatever
+// span is active on ctx, so a log line can be correlated with its trace. It
+// returns nil when ctx carries no valid span context.
+func TraceFields(ctx context.Context) []zap.Field {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []zap.Field{
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	}
+}
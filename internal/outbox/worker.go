@@ -0,0 +1,92 @@
+// Package outbox drives the transactional outbox pattern: it polls
+// follow_outbox rows written by repository.Repository's Follow/Unfollow
+// transactions and hands them to an events.EventPublisher, decoupling
+// publishing from the request path.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/pratilipi/follow-service/internal/events"
+	"github.com/pratilipi/follow-service/internal/models"
+	"github.com/pratilipi/follow-service/internal/repository"
+	"go.uber.org/zap"
+)
+
+const defaultBatchSize = 100
+
+type Worker struct {
+	repo      *repository.Repository
+	publisher events.EventPublisher
+	broker    *events.Broker
+	logger    *zap.Logger
+	interval  time.Duration
+	batchSize int32
+}
+
+// NewWorker builds a Worker that publishes outbox rows to publisher and, on
+// success, fans a FollowEvent out to broker so live StreamFollowerEvents
+// subscribers see it too.
+func NewWorker(repo *repository.Repository, publisher events.EventPublisher, broker *events.Broker, logger *zap.Logger, interval time.Duration) *Worker {
+	return &Worker{
+		repo:      repo,
+		publisher: publisher,
+		broker:    broker,
+		logger:    logger,
+		interval:  interval,
+		batchSize: defaultBatchSize,
+	}
+}
+
+// Run polls for unpublished outbox rows on a fixed interval until ctx is
+// cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			published, err := w.repo.ProcessOutboxBatch(ctx, w.batchSize, w.publish)
+			if err != nil {
+				w.logger.Error("failed to process outbox batch", zap.Error(err))
+				continue
+			}
+			if published > 0 {
+				w.logger.Info("published outbox events", zap.Int("count", published))
+			}
+		}
+	}
+}
+
+func (w *Worker) publish(event models.OutboxEvent) error {
+	ctx := context.Background()
+
+	var eventType events.FollowEventType
+	switch event.EventType {
+	case models.OutboxEventFollowed:
+		eventType = events.FollowEventTypeFollowed
+		if err := w.publisher.PublishFollow(ctx, event.FollowerID, event.FollowingID); err != nil {
+			return err
+		}
+	case models.OutboxEventUnfollowed:
+		eventType = events.FollowEventTypeUnfollowed
+		if err := w.publisher.PublishUnfollow(ctx, event.FollowerID, event.FollowingID); err != nil {
+			return err
+		}
+	default:
+		w.logger.Warn("skipping outbox event with unknown type", zap.String("event_type", string(event.EventType)))
+		return nil
+	}
+
+	w.broker.Publish(event.FollowingID, events.FollowEvent{
+		Type:      eventType,
+		ActorID:   event.FollowerID,
+		Timestamp: event.CreatedAt,
+	})
+
+	return nil
+}
@@ -5,8 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/lib/pq"
 	"github.com/pratilipi/follow-service/internal/models"
+	"github.com/pratilipi/follow-service/internal/observability"
 )
 
 var (
@@ -15,8 +18,13 @@ var (
 	ErrNotFollowing      = errors.New("not following this user")
 	ErrSelfFollow        = errors.New("cannot follow yourself")
 	ErrDuplicateUsername = errors.New("username already exists")
+	ErrTooManyTargets    = errors.New("too many target ids")
 )
 
+// MaxRelationshipTargets bounds CheckRelationships so a single request can't
+// force an unbounded IN (...) scan.
+const MaxRelationshipTargets = 200
+
 type Repository struct {
 	db *sql.DB
 }
@@ -25,19 +33,47 @@ func New(db *sql.DB) *Repository {
 	return &Repository{db: db}
 }
 
+// uniqueViolationCode is the PostgreSQL SQLSTATE for a unique_violation.
+const uniqueViolationCode = "23505"
+
+// isUniqueViolation reports whether err is a unique-constraint violation,
+// e.g. two concurrent Follow calls racing to insert the same
+// (follower_id, following_id) pair into follow_activities.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode
+}
+
+// withQuerySpan runs fn, a single SQL call, inside a child span named name,
+// so a slow query shows up on its own in the RPC's trace instead of being
+// folded into the method's overall span.
+func withQuerySpan(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	spanCtx, span := observability.StartSpan(ctx, name)
+	defer span.End()
+	return fn(spanCtx)
+}
+
+// WarmCache is a no-op on the uncached repository; it exists so Repository
+// satisfies the same interface as cache.CachedRepository.
+func (r *Repository) WarmCache(ctx context.Context, userID int32) error {
+	return nil
+}
+
 func (r *Repository) GetUser(ctx context.Context, userID int32) (*models.User, error) {
 	query := `SELECT id, username, email, followers_count, following_count, created_at FROM users WHERE id = $1`
-	
+
 	var user models.User
-	err := r.db.QueryRowContext(ctx, query, userID).Scan(
-		&user.ID,
-		&user.Username,
-		&user.Email,
-		&user.FollowersCount,
-		&user.FollowingCount,
-		&user.CreatedAt,
-	)
-	
+	err := withQuerySpan(ctx, "pg.get_user", func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, query, userID).Scan(
+			&user.ID,
+			&user.Username,
+			&user.Email,
+			&user.FollowersCount,
+			&user.FollowingCount,
+			&user.CreatedAt,
+		)
+	})
+
 	if err == sql.ErrNoRows {
 		return nil, ErrUserNotFound
 	}
@@ -51,13 +87,20 @@ func (r *Repository) GetUser(ctx context.Context, userID int32) (*models.User, e
 func (r *Repository) ListUsers(ctx context.Context, limit, offset int32) ([]*models.User, int32, error) {
 	countQuery := `SELECT COUNT(*) FROM users`
 	var total int32
-	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&total); err != nil {
+	err := withQuerySpan(ctx, "pg.list_users.count", func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, countQuery).Scan(&total)
+	})
+	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count users: %w", err)
 	}
 
 	query := `SELECT id, username, email, followers_count, following_count, created_at FROM users ORDER BY id LIMIT $1 OFFSET $2`
-	
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+
+	var rows *sql.Rows
+	err = withQuerySpan(ctx, "pg.list_users.select", func(ctx context.Context) error {
+		rows, err = r.db.QueryContext(ctx, query, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to list users: %w", err)
 	}
@@ -75,6 +118,10 @@ func (r *Repository) ListUsers(ctx context.Context, limit, offset int32) ([]*mod
 	return users, total, nil
 }
 
+// Follow records a follow activity from followerID to followingID. Following
+// again after a prior unfollow flips the existing (cancelled) activity row
+// back on rather than inserting a new one, so the full history survives in
+// follow_activities.
 func (r *Repository) Follow(ctx context.Context, followerID, followingID int32) error {
 	if followerID == followingID {
 		return ErrSelfFollow
@@ -93,33 +140,87 @@ func (r *Repository) Follow(ctx context.Context, followerID, followingID int32)
 	}
 	defer tx.Rollback()
 
-	query := `INSERT INTO follows (follower_id, following_id) VALUES ($1, $2)`
-	_, err = tx.ExecContext(ctx, query, followerID, followingID)
-	
-	if err != nil {
+	var cancelled bool
+	err = withQuerySpan(ctx, "pg.follow.lock_activity", func(ctx context.Context) error {
+		return tx.QueryRowContext(ctx,
+			`SELECT cancelled FROM follow_activities WHERE follower_id = $1 AND following_id = $2 FOR UPDATE`,
+			followerID, followingID,
+		).Scan(&cancelled)
+	})
+
+	switch {
+	case err == sql.ErrNoRows:
+		err = withQuerySpan(ctx, "pg.follow.insert_activity", func(ctx context.Context) error {
+			_, err := tx.ExecContext(ctx,
+				`INSERT INTO follow_activities (follower_id, following_id, cancelled) VALUES ($1, $2, false)`,
+				followerID, followingID,
+			)
+			return err
+		})
 		if isUniqueViolation(err) {
+			// Lost the race with a concurrent Follow for the same pair
+			// between our FOR UPDATE lookup (which found no row) and this
+			// INSERT; the other transaction owns the row now.
 			return ErrAlreadyFollowing
 		}
-		return fmt.Errorf("failed to create follow: %w", err)
+		if err != nil {
+			return fmt.Errorf("failed to create follow activity: %w", err)
+		}
+	case err != nil:
+		return fmt.Errorf("failed to look up follow activity: %w", err)
+	case !cancelled:
+		return ErrAlreadyFollowing
+	default:
+		err = withQuerySpan(ctx, "pg.follow.reactivate_activity", func(ctx context.Context) error {
+			_, err := tx.ExecContext(ctx,
+				`UPDATE follow_activities SET cancelled = false, updated_at = now() WHERE follower_id = $1 AND following_id = $2`,
+				followerID, followingID,
+			)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reactivate follow activity: %w", err)
+		}
 	}
 
-	_, err = tx.ExecContext(ctx, `UPDATE users SET following_count = following_count + 1 WHERE id = $1`, followerID)
+	// Counts only move on an actual not-following -> following transition,
+	// which is exactly the two branches above that didn't return early.
+	err = withQuerySpan(ctx, "pg.follow.incr_following_count", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx, `UPDATE users SET following_count = following_count + 1 WHERE id = $1`, followerID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update following count: %w", err)
 	}
 
-	_, err = tx.ExecContext(ctx, `UPDATE users SET followers_count = followers_count + 1 WHERE id = $1`, followingID)
+	err = withQuerySpan(ctx, "pg.follow.incr_followers_count", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx, `UPDATE users SET followers_count = followers_count + 1 WHERE id = $1`, followingID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update followers count: %w", err)
 	}
 
+	err = withQuerySpan(ctx, "pg.follow.insert_outbox", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO follow_outbox (event_type, follower_id, following_id) VALUES ($1, $2, $3)`,
+			models.OutboxEventFollowed, followerID, followingID,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue follow outbox event: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	
+
 	return nil
 }
 
+// Unfollow marks the follow activity from followerID to followingID as
+// cancelled rather than deleting it, preserving it for GetFollowHistory.
 func (r *Repository) Unfollow(ctx context.Context, followerID, followingID int32) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
@@ -127,56 +228,87 @@ func (r *Repository) Unfollow(ctx context.Context, followerID, followingID int32
 	}
 	defer tx.Rollback()
 
-	query := `DELETE FROM follows WHERE follower_id = $1 AND following_id = $2`
-	
-	result, err := tx.ExecContext(ctx, query, followerID, followingID)
+	var result sql.Result
+	err = withQuerySpan(ctx, "pg.unfollow.cancel_activity", func(ctx context.Context) error {
+		var execErr error
+		result, execErr = tx.ExecContext(ctx,
+			`UPDATE follow_activities SET cancelled = true, updated_at = now()
+			 WHERE follower_id = $1 AND following_id = $2 AND cancelled = false`,
+			followerID, followingID,
+		)
+		return execErr
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete follow: %w", err)
+		return fmt.Errorf("failed to cancel follow activity: %w", err)
 	}
-	
+
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
-	
+
 	if rowsAffected == 0 {
 		return ErrNotFollowing
 	}
 
-	_, err = tx.ExecContext(ctx, `UPDATE users SET following_count = following_count - 1 WHERE id = $1`, followerID)
+	err = withQuerySpan(ctx, "pg.unfollow.decr_following_count", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx, `UPDATE users SET following_count = following_count - 1 WHERE id = $1`, followerID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update following count: %w", err)
 	}
 
-	_, err = tx.ExecContext(ctx, `UPDATE users SET followers_count = followers_count - 1 WHERE id = $1`, followingID)
+	err = withQuerySpan(ctx, "pg.unfollow.decr_followers_count", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx, `UPDATE users SET followers_count = followers_count - 1 WHERE id = $1`, followingID)
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("failed to update followers count: %w", err)
 	}
 
+	err = withQuerySpan(ctx, "pg.unfollow.insert_outbox", func(ctx context.Context) error {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO follow_outbox (event_type, follower_id, following_id) VALUES ($1, $2, $3)`,
+			models.OutboxEventUnfollowed, followerID, followingID,
+		)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to enqueue unfollow outbox event: %w", err)
+	}
+
 	if err = tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	
+
 	return nil
 }
 
 func (r *Repository) GetFollowers(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error) {
-	countQuery := `SELECT COUNT(*) FROM follows WHERE following_id = $1`
+	countQuery := `SELECT COUNT(*) FROM follow_activities WHERE following_id = $1 AND cancelled = false`
 	var total int32
-	if err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+	err := withQuerySpan(ctx, "pg.get_followers.count", func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total)
+	})
+	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count followers: %w", err)
 	}
 
 	query := `
-		SELECT u.id, u.username, u.email, u.followers_count, u.following_count, u.created_at 
+		SELECT u.id, u.username, u.email, u.followers_count, u.following_count, u.created_at
 		FROM users u
-		INNER JOIN follows f ON u.id = f.follower_id
-		WHERE f.following_id = $1
+		INNER JOIN follow_activities f ON u.id = f.follower_id
+		WHERE f.following_id = $1 AND f.cancelled = false
 		ORDER BY f.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
-	
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+
+	var rows *sql.Rows
+	err = withQuerySpan(ctx, "pg.get_followers.select", func(ctx context.Context) error {
+		rows, err = r.db.QueryContext(ctx, query, userID, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get followers: %w", err)
 	}
@@ -195,22 +327,29 @@ func (r *Repository) GetFollowers(ctx context.Context, userID, limit, offset int
 }
 
 func (r *Repository) GetFollowing(ctx context.Context, userID, limit, offset int32) ([]*models.User, int32, error) {
-	countQuery := `SELECT COUNT(*) FROM follows WHERE follower_id = $1`
+	countQuery := `SELECT COUNT(*) FROM follow_activities WHERE follower_id = $1 AND cancelled = false`
 	var total int32
-	if err := r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total); err != nil {
+	err := withQuerySpan(ctx, "pg.get_following.count", func(ctx context.Context) error {
+		return r.db.QueryRowContext(ctx, countQuery, userID).Scan(&total)
+	})
+	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count following: %w", err)
 	}
 
 	query := `
-		SELECT u.id, u.username, u.email, u.followers_count, u.following_count, u.created_at 
+		SELECT u.id, u.username, u.email, u.followers_count, u.following_count, u.created_at
 		FROM users u
-		INNER JOIN follows f ON u.id = f.following_id
-		WHERE f.follower_id = $1
+		INNER JOIN follow_activities f ON u.id = f.following_id
+		WHERE f.follower_id = $1 AND f.cancelled = false
 		ORDER BY f.created_at DESC
 		LIMIT $2 OFFSET $3
 	`
-	
-	rows, err := r.db.QueryContext(ctx, query, userID, limit, offset)
+
+	var rows *sql.Rows
+	err = withQuerySpan(ctx, "pg.get_following.select", func(ctx context.Context) error {
+		rows, err = r.db.QueryContext(ctx, query, userID, limit, offset)
+		return err
+	})
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get following: %w", err)
 	}
@@ -228,8 +367,222 @@ func (r *Repository) GetFollowing(ctx context.Context, userID, limit, offset int
 	return users, total, nil
 }
 
-func isUniqueViolation(err error) bool {
-	return err != nil && (
-		err.Error() == `pq: duplicate key value violates unique constraint "follows_pkey"` ||
-		err.Error() == `duplicate key value violates unique constraint "follows_pkey"`)
+// GetFollowHistory returns the raw follow_activities rows for userID as
+// either follower or followee, including cancelled (unfollowed) entries, so
+// callers can power an audit trail or activity feed. Rows are ordered newest
+// first and optionally bounded by since.
+func (r *Repository) GetFollowHistory(ctx context.Context, userID int32, since time.Time, limit int32) ([]*models.FollowActivity, error) {
+	query := `
+		SELECT id, follower_id, following_id, cancelled, created_at, updated_at
+		FROM follow_activities
+		WHERE (follower_id = $1 OR following_id = $1) AND updated_at >= $2
+		ORDER BY updated_at DESC
+		LIMIT $3
+	`
+
+	var rows *sql.Rows
+	err := withQuerySpan(ctx, "pg.get_follow_history.select", func(ctx context.Context) error {
+		var err error
+		rows, err = r.db.QueryContext(ctx, query, userID, since, limit)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get follow history: %w", err)
+	}
+	defer rows.Close()
+
+	activities := make([]*models.FollowActivity, 0)
+	for rows.Next() {
+		var activity models.FollowActivity
+		if err := rows.Scan(
+			&activity.ID,
+			&activity.FollowerID,
+			&activity.FollowingID,
+			&activity.Cancelled,
+			&activity.CreatedAt,
+			&activity.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan follow activity: %w", err)
+		}
+		activities = append(activities, &activity)
+	}
+
+	return activities, nil
+}
+
+// CheckRelationships reports, for each of targetIDs, whether viewerID follows
+// them and whether they follow viewerID back. It resolves in two indexed IN
+// queries rather than one GetFollowers-style call per target, so a feed of N
+// profiles costs two round trips instead of N.
+func (r *Repository) CheckRelationships(ctx context.Context, viewerID int32, targetIDs []int32) (map[int32]models.RelationshipFlags, error) {
+	if len(targetIDs) > MaxRelationshipTargets {
+		return nil, ErrTooManyTargets
+	}
+
+	flags := make(map[int32]models.RelationshipFlags, len(targetIDs))
+	for _, id := range targetIDs {
+		flags[id] = models.RelationshipFlags{}
+	}
+	if len(targetIDs) == 0 {
+		return flags, nil
+	}
+
+	var followingRows *sql.Rows
+	err := withQuerySpan(ctx, "pg.check_relationships.following", func(ctx context.Context) error {
+		var err error
+		followingRows, err = r.db.QueryContext(ctx,
+			`SELECT following_id FROM follow_activities
+			 WHERE follower_id = $1 AND cancelled = false AND following_id = ANY($2)`,
+			viewerID, pq.Array(targetIDs),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check following: %w", err)
+	}
+	defer followingRows.Close()
+
+	for followingRows.Next() {
+		var targetID int32
+		if err := followingRows.Scan(&targetID); err != nil {
+			return nil, fmt.Errorf("failed to scan following target: %w", err)
+		}
+		f := flags[targetID]
+		f.IsFollowing = true
+		flags[targetID] = f
+	}
+	if err := followingRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check following: %w", err)
+	}
+
+	var followerRows *sql.Rows
+	err = withQuerySpan(ctx, "pg.check_relationships.followers", func(ctx context.Context) error {
+		var err error
+		followerRows, err = r.db.QueryContext(ctx,
+			`SELECT follower_id FROM follow_activities
+			 WHERE following_id = $1 AND cancelled = false AND follower_id = ANY($2)`,
+			viewerID, pq.Array(targetIDs),
+		)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check followers: %w", err)
+	}
+	defer followerRows.Close()
+
+	for followerRows.Next() {
+		var targetID int32
+		if err := followerRows.Scan(&targetID); err != nil {
+			return nil, fmt.Errorf("failed to scan follower target: %w", err)
+		}
+		f := flags[targetID]
+		f.IsFollowedBy = true
+		flags[targetID] = f
+	}
+	if err := followerRows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to check followers: %w", err)
+	}
+
+	return flags, nil
+}
+
+// IsFollowing is a single-target shortcut over CheckRelationships for the
+// common case of rendering one profile's follow button.
+func (r *Repository) IsFollowing(ctx context.Context, followerID, followingID int32) (bool, error) {
+	flags, err := r.CheckRelationships(ctx, followerID, []int32{followingID})
+	if err != nil {
+		return false, err
+	}
+	return flags[followingID].IsFollowing, nil
+}
+
+// ProcessOutboxBatch locks up to batchSize unpublished follow_outbox rows
+// with SELECT ... FOR UPDATE SKIP LOCKED so concurrent workers never pick up
+// the same row, hands each to publish, and marks the ones that succeed with
+// published_at = now() before committing. It returns the number published.
+func (r *Repository) ProcessOutboxBatch(ctx context.Context, batchSize int32, publish func(models.OutboxEvent) error) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var rows *sql.Rows
+	err = withQuerySpan(ctx, "pg.process_outbox_batch.select", func(ctx context.Context) error {
+		var err error
+		rows, err = tx.QueryContext(ctx,
+			`SELECT id, event_type, follower_id, following_id, created_at
+			 FROM follow_outbox
+			 WHERE published_at IS NULL
+			 ORDER BY id
+			 LIMIT $1
+			 FOR UPDATE SKIP LOCKED`,
+			batchSize,
+		)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to select outbox batch: %w", err)
+	}
+
+	batch := make([]models.OutboxEvent, 0, batchSize)
+	for rows.Next() {
+		var e models.OutboxEvent
+		if err := rows.Scan(&e.ID, &e.EventType, &e.FollowerID, &e.FollowingID, &e.CreatedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		batch = append(batch, e)
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+		return 0, fmt.Errorf("failed to select outbox batch: %w", err)
+	}
+	rows.Close()
+
+	published := 0
+	for _, e := range batch {
+		if err := publish(e); err != nil {
+			continue
+		}
+		err := withQuerySpan(ctx, "pg.process_outbox_batch.mark_published", func(ctx context.Context) error {
+			_, err := tx.ExecContext(ctx, `UPDATE follow_outbox SET published_at = now() WHERE id = $1`, e.ID)
+			return err
+		})
+		if err != nil {
+			return published, fmt.Errorf("failed to mark outbox event published: %w", err)
+		}
+		published++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return published, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return published, nil
+}
+
+// CompactCancelledActivities purges cancelled follow_activities rows that
+// haven't changed since before cutoff, keeping the audit table from growing
+// unbounded. It returns the number of rows removed.
+func (r *Repository) CompactCancelledActivities(ctx context.Context, cutoff time.Time) (int64, error) {
+	var result sql.Result
+	err := withQuerySpan(ctx, "pg.compact_cancelled_activities.delete", func(ctx context.Context) error {
+		var err error
+		result, err = r.db.ExecContext(ctx,
+			`DELETE FROM follow_activities WHERE cancelled = true AND updated_at < $1`,
+			cutoff,
+		)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to compact follow activities: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
 }
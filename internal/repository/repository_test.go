@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/pratilipi/follow-service/internal/models"
+)
+
+func newTestRepository(t *testing.T) (*Repository, sqlmock.Sqlmock) {
+	t.Helper()
+
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherRegexp))
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return New(db), mock
+}
+
+func TestProcessOutboxBatchPublishesAndMarksRows(t *testing.T) {
+	repo, mock := newTestRepository(t)
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "event_type", "follower_id", "following_id", "created_at"}).
+		AddRow(int64(1), models.OutboxEventFollowed, int32(10), int32(20), now).
+		AddRow(int64(2), models.OutboxEventUnfollowed, int32(11), int32(21), now)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, event_type, follower_id, following_id, created_at").
+		WithArgs(int32(100)).
+		WillReturnRows(rows)
+	mock.ExpectExec("UPDATE follow_outbox SET published_at = now\\(\\) WHERE id = \\$1").
+		WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE follow_outbox SET published_at = now\\(\\) WHERE id = \\$1").
+		WithArgs(int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	var published []models.OutboxEvent
+	published_, err := repo.ProcessOutboxBatch(context.Background(), 100, func(e models.OutboxEvent) error {
+		published = append(published, e)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ProcessOutboxBatch: %v", err)
+	}
+	if published_ != 2 {
+		t.Fatalf("expected 2 published, got %d", published_)
+	}
+	if len(published) != 2 || published[0].ID != 1 || published[1].ID != 2 {
+		t.Fatalf("unexpected published events: %+v", published)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestProcessOutboxBatchSkipsRowOnPublishError(t *testing.T) {
+	repo, mock := newTestRepository(t)
+
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"id", "event_type", "follower_id", "following_id", "created_at"}).
+		AddRow(int64(1), models.OutboxEventFollowed, int32(10), int32(20), now)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, event_type, follower_id, following_id, created_at").
+		WithArgs(int32(100)).
+		WillReturnRows(rows)
+	// No ExpectExec for the UPDATE: a failed publish must not be marked published.
+	mock.ExpectCommit()
+
+	publishErr := errors.New("publisher unavailable")
+	published, err := repo.ProcessOutboxBatch(context.Background(), 100, func(e models.OutboxEvent) error {
+		return publishErr
+	})
+	if err != nil {
+		t.Fatalf("ProcessOutboxBatch: %v", err)
+	}
+	if published != 0 {
+		t.Fatalf("expected 0 published, got %d", published)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}
+
+func TestProcessOutboxBatchRollsBackOnSelectError(t *testing.T) {
+	repo, mock := newTestRepository(t)
+
+	selectErr := errors.New("connection reset")
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, event_type, follower_id, following_id, created_at").
+		WithArgs(int32(50)).
+		WillReturnError(selectErr)
+	mock.ExpectRollback()
+
+	_, err := repo.ProcessOutboxBatch(context.Background(), 50, func(e models.OutboxEvent) error {
+		t.Fatal("publish should not be called when the select fails")
+		return nil
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet sqlmock expectations: %v", err)
+	}
+}